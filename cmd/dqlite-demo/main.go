@@ -0,0 +1,96 @@
+// Command dqlite-demo runs a single dqlite node, optionally fronted by the
+// httpapi HTTP/JSON gateway so it can be driven without linking the Go
+// driver.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/canonical/go-dqlite/httpapi"
+	"github.com/canonical/go-dqlite/internal/bindings"
+)
+
+func main() {
+	var (
+		id        = flag.Uint64("id", 1, "node ID")
+		address   = flag.String("address", "127.0.0.1:9001", "bind address")
+		dir       = flag.String("dir", "/tmp/dqlite-demo", "data directory")
+		httpAddr  = flag.String("http", "", "if set, serve the HTTP/JSON gateway on this address")
+		basicUser = flag.String("http-user", "", "basic auth username for the HTTP gateway")
+		basicPass = flag.String("http-pass", "", "basic auth password for the HTTP gateway")
+	)
+	flag.Parse()
+
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		log.Fatalf("create data directory: %v", err)
+	}
+
+	node, err := newNodeWithRetry(*id, *address, *dir)
+	if err != nil {
+		log.Fatalf("create node: %v", err)
+	}
+	defer node.Close()
+
+	if err := node.Start(); err != nil {
+		log.Fatalf("start node: %v", err)
+	}
+	defer node.Stop()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if *httpAddr != "" {
+		db, err := sql.Open("dqlite", fmt.Sprintf("%s?_node=%s", *dir, *address))
+		if err != nil {
+			log.Fatalf("open dqlite driver: %v", err)
+		}
+		defer db.Close()
+
+		server := httpapi.New(node, db, httpapi.Config{
+			Address:           *httpAddr,
+			BasicAuthUsername: *basicUser,
+			BasicAuthPassword: *basicPass,
+		})
+		go func() {
+			if err := server.Serve(); err != nil {
+				log.Printf("http gateway stopped: %v", err)
+			}
+		}()
+		defer server.Close(context.Background())
+	}
+
+	<-ctx.Done()
+}
+
+// newNodeWithRetry creates the node, retrying a few times if the bind
+// address is still held by a just-exited instance of this same process
+// (bindings.ErrAddressInUse) - the one NewNode failure mode worth waiting
+// out rather than failing fast on, e.g. during a systemd restart. Every
+// other failure, including other *bindings.NodeError codes, is returned
+// immediately.
+func newNodeWithRetry(id uint64, address, dir string) (*bindings.Node, error) {
+	const attempts = 5
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		var node *bindings.Node
+		node, err = bindings.NewNode(id, address, dir)
+		if err == nil {
+			return node, nil
+		}
+		if !errors.Is(err, bindings.ErrAddressInUse) {
+			return nil, err
+		}
+		log.Printf("address %s still in use, retrying (%d/%d)", address, i+1, attempts)
+		time.Sleep(time.Second)
+	}
+	return nil, err
+}
@@ -0,0 +1,77 @@
+package grpcadmin
+
+//go:generate buf generate proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/canonical/go-dqlite/grpcadmin/adminpb"
+	"github.com/canonical/go-dqlite/internal/bindings"
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// adminServer implements adminpb.AdminServer (generated from
+// proto/admin.proto) against a single local node.
+type adminServer struct {
+	adminpb.UnimplementedAdminServer
+
+	node *bindings.Node
+}
+
+func newAdminServer(node *bindings.Node) *adminServer {
+	return &adminServer{node: node}
+}
+
+func (a *adminServer) Recover(ctx context.Context, req *adminpb.RecoverRequest) (*adminpb.RecoverReply, error) {
+	cluster := make([]protocol.NodeInfo, len(req.Cluster))
+	for i, info := range req.Cluster {
+		cluster[i] = protocol.NodeInfo{
+			ID:      info.Id,
+			Address: info.Address,
+			Role:    protocol.NodeRole(info.Role),
+		}
+	}
+	if err := a.node.RecoverExt(cluster); err != nil {
+		return nil, status.Errorf(codes.Internal, "recover: %v", err)
+	}
+	return &adminpb.RecoverReply{}, nil
+}
+
+func (a *adminServer) SetSnapshotParams(ctx context.Context, req *adminpb.SetSnapshotParamsRequest) (*adminpb.SetSnapshotParamsReply, error) {
+	params := bindings.SnapshotParams{Threshold: req.Threshold, Trailing: req.Trailing}
+	if err := a.node.SetSnapshotParams(params); err != nil {
+		return nil, status.Errorf(codes.Internal, "set snapshot params: %v", err)
+	}
+	return &adminpb.SetSnapshotParamsReply{}, nil
+}
+
+func (a *adminServer) SetFailureDomain(ctx context.Context, req *adminpb.SetFailureDomainRequest) (*adminpb.SetFailureDomainReply, error) {
+	if err := a.node.SetFailureDomain(req.Code); err != nil {
+		return nil, status.Errorf(codes.Internal, "set failure domain: %v", err)
+	}
+	return &adminpb.SetFailureDomainReply{}, nil
+}
+
+func (a *adminServer) Freeze(ctx context.Context, req *adminpb.FreezeRequest) (*adminpb.FreezeReply, error) {
+	if err := a.node.Freeze(); err != nil {
+		return nil, status.Errorf(codes.Internal, "freeze: %v", err)
+	}
+	return &adminpb.FreezeReply{}, nil
+}
+
+func (a *adminServer) Unfreeze(ctx context.Context, req *adminpb.UnfreezeRequest) (*adminpb.UnfreezeReply, error) {
+	if err := a.node.Unfreeze(); err != nil {
+		return nil, status.Errorf(codes.Internal, "unfreeze: %v", err)
+	}
+	return &adminpb.UnfreezeReply{}, nil
+}
+
+func (a *adminServer) TransferLeadership(ctx context.Context, req *adminpb.TransferLeadershipRequest) (*adminpb.TransferLeadershipReply, error) {
+	if err := a.node.TransferLeadership(req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "transfer leadership: %v", err)
+	}
+	return &adminpb.TransferLeadershipReply{}, nil
+}
@@ -0,0 +1,71 @@
+package grpcadmin
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/canonical/go-dqlite/internal/bindings"
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// maxServingLag is the largest applied-log lag behind the leader a voter
+// may have and still be reported SERVING. Above this, the node is still
+// reachable but its reads are stale enough that callers shouldn't be
+// routed to it.
+const maxServingLag = 100
+
+// maxServingContactAge is the longest a voter may go without hearing from
+// the leader and still be reported SERVING. This catches a partitioned
+// voter that maxServingLag alone would miss: cut off from AppendEntries,
+// its lag behind the leader stops growing instead of climbing.
+const maxServingContactAge = 5 * time.Second
+
+// healthServer implements grpc.health.v1.Health, reporting SERVING only
+// while the local node is a voter, not frozen for maintenance, and caught
+// up with the leader within maxServingLag entries.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	node *bindings.Node
+}
+
+func newHealthServer(node *bindings.Node) *healthServer {
+	return &healthServer{node: node}
+}
+
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if h.servingStatus() != healthpb.HealthCheckResponse_SERVING {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (h *healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	return status.Error(codes.Unimplemented, "watch is not supported")
+}
+
+func (h *healthServer) servingStatus() healthpb.HealthCheckResponse_ServingStatus {
+	if h.node.Frozen() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	address := h.node.GetBindAddress()
+	isVoter := false
+	for _, info := range h.node.GetClusterInfo() {
+		if info.Address == address && info.Role == protocol.Voter {
+			isVoter = true
+			break
+		}
+	}
+	if !isVoter {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	if !h.node.CaughtUp(maxServingLag, maxServingContactAge) {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,110 @@
+// Package grpcadmin runs a gRPC server exposing cluster health and admin
+// operations for a single dqlite node, plus a REST proxy for the admin
+// operations so operators don't need a gRPC client to drive them.
+package grpcadmin
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/canonical/go-dqlite/grpcadmin/adminpb"
+	"github.com/canonical/go-dqlite/internal/bindings"
+)
+
+// Config controls how Server listens and authenticates.
+type Config struct {
+	// GRPCAddress is the TCP address the gRPC server listens on.
+	GRPCAddress string
+
+	// RESTAddress, if non-empty, serves the gRPC-gateway REST proxy on
+	// this address.
+	RESTAddress string
+
+	// TLS, if non-nil, is used both to serve the gRPC listener and to
+	// dial it from the REST proxy. Set ClientAuth to
+	// tls.RequireAndVerifyClientCert for mutual auth, consistent with
+	// the dialer TLS support in the bindings package.
+	TLS *tls.Config
+}
+
+// Server bundles the gRPC health/admin services and, optionally, the admin
+// service's REST proxy.
+type Server struct {
+	config Config
+	node   *bindings.Node
+
+	grpc *grpc.Server
+	rest *http.Server
+}
+
+// New creates a Server for node.
+func New(node *bindings.Node, config Config) *Server {
+	var opts []grpc.ServerOption
+	if config.TLS != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config.TLS)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(grpcServer, newHealthServer(node))
+	adminpb.RegisterAdminServer(grpcServer, newAdminServer(node))
+
+	return &Server{config: config, node: node, grpc: grpcServer}
+}
+
+// Serve starts the gRPC listener, and the REST proxy if configured. It
+// blocks until the gRPC listener is closed.
+func (s *Server) Serve() error {
+	listener, err := net.Listen("tcp", s.config.GRPCAddress)
+	if err != nil {
+		return err
+	}
+
+	if s.config.RESTAddress != "" {
+		if err := s.serveREST(); err != nil {
+			listener.Close()
+			return err
+		}
+	}
+
+	return s.grpc.Serve(listener)
+}
+
+// serveREST dials the gRPC server in-process and registers the generated
+// gateway handlers, which translate REST requests into the same gRPC calls
+// served above.
+func (s *Server) serveREST() error {
+	ctx := context.Background()
+
+	var dialOpts []grpc.DialOption
+	if s.config.TLS != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(s.config.TLS)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	mux := runtime.NewServeMux()
+	if err := adminpb.RegisterAdminHandlerFromEndpoint(ctx, mux, s.config.GRPCAddress, dialOpts); err != nil {
+		return err
+	}
+
+	s.rest = &http.Server{Addr: s.config.RESTAddress, Handler: mux}
+	go s.rest.ListenAndServe()
+	return nil
+}
+
+// Close shuts down the gRPC server and, if running, the REST proxy.
+func (s *Server) Close(ctx context.Context) error {
+	s.grpc.GracefulStop()
+	if s.rest != nil {
+		return s.rest.Shutdown(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// Package httpapi runs an HTTP/JSON gateway in front of a dqlite node, so
+// applications can talk to dqlite over REST instead of linking the Go
+// driver. The request/response shapes follow rqlite's /db/execute and
+// /db/query endpoints closely enough that existing rqlite HTTP clients
+// mostly just work.
+package httpapi
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/canonical/go-dqlite/internal/bindings"
+)
+
+// ConsistencyLevel selects how a query is routed, mirroring rqlite's
+// ?level= query parameter.
+type ConsistencyLevel string
+
+const (
+	// LevelStrong requires the local node to be leader. It is enforced
+	// exactly like LevelWeak: the query is not routed through raft (see
+	// enforceConsistency).
+	LevelStrong ConsistencyLevel = "strong"
+	// LevelWeak only requires the local node to be leader.
+	LevelWeak ConsistencyLevel = "weak"
+	// LevelNone serves from local state regardless of leadership.
+	LevelNone ConsistencyLevel = "none"
+)
+
+// Config controls how Server listens and authenticates.
+type Config struct {
+	// Address is the TCP address to listen on, e.g. ":8001".
+	Address string
+
+	// TLS, if non-nil, makes the server serve HTTPS.
+	TLS *tls.Config
+
+	// If BasicAuthUsername is set, every request except /readyz must carry
+	// HTTP basic auth credentials matching BasicAuthUsername and
+	// BasicAuthPassword.
+	BasicAuthUsername string
+	BasicAuthPassword string
+}
+
+// Server runs the HTTP/JSON gateway. It translates requests into queries
+// against a database/sql handle opened through the dqlite driver, and
+// reports status drawn from the underlying bindings.Node.
+type Server struct {
+	config Config
+	node   *bindings.Node
+	db     *sql.DB
+	http   *http.Server
+}
+
+// New creates a Server in front of node, issuing statements against db (a
+// *sql.DB opened with the dqlite driver).
+func New(node *bindings.Node, db *sql.DB, config Config) *Server {
+	s := &Server{config: config, node: node, db: db}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/db/execute", s.withAuth(s.handleExecute))
+	mux.HandleFunc("/db/query", s.withAuth(s.handleQuery))
+	mux.HandleFunc("/status", s.withAuth(s.handleStatus))
+	mux.HandleFunc("/nodes", s.withAuth(s.handleNodes))
+	mux.HandleFunc("/readyz", s.handleReady)
+
+	s.http = &http.Server{
+		Addr:      config.Address,
+		Handler:   mux,
+		TLSConfig: config.TLS,
+	}
+	return s
+}
+
+// Serve starts accepting connections and blocks until the server is closed
+// or an unrecoverable error occurs, in the same style as http.Server.Serve.
+func (s *Server) Serve() error {
+	if s.config.TLS != nil {
+		return s.http.ListenAndServeTLS("", "")
+	}
+	return s.http.ListenAndServe()
+}
+
+// Close shuts the server down, waiting for in-flight requests to finish.
+func (s *Server) Close(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
+
+func (s *Server) withAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.config.BasicAuthUsername != "" {
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != s.config.BasicAuthUsername || pass != s.config.BasicAuthPassword {
+				w.Header().Set("WWW-Authenticate", `Basic realm="dqlite"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+// statement is a single parameterized SQL statement, batched requests are
+// simply a JSON array of these.
+type statement struct {
+	SQL  string        `json:"sql"`
+	Args []interface{} `json:"args,omitempty"`
+}
+
+func readStatements(r *http.Request) ([]statement, error) {
+	body := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("gzip body: %w", err)
+		}
+		defer gz.Close()
+		body = gz
+	}
+
+	var statements []statement
+	if err := json.NewDecoder(body).Decode(&statements); err != nil {
+		return nil, fmt.Errorf("decode body: %w", err)
+	}
+	return statements, nil
+}
+
+func consistencyLevel(r *http.Request) ConsistencyLevel {
+	level := ConsistencyLevel(strings.ToLower(r.URL.Query().Get("level")))
+	switch level {
+	case LevelWeak, LevelNone:
+		return level
+	default:
+		return LevelStrong
+	}
+}
+
+// enforceConsistency reports whether the request may proceed given its
+// ?level=. LevelNone always proceeds, serving whatever this node's local
+// state is regardless of leadership. LevelStrong and LevelWeak both require
+// this node to currently be leader - telling them apart further (a
+// linearizable read index vs. a plain leader-local read) needs a primitive
+// below database/sql that this gateway doesn't have, so today they're
+// enforced identically. On failure it writes the error response itself.
+func (s *Server) enforceConsistency(w http.ResponseWriter, r *http.Request) bool {
+	level := consistencyLevel(r)
+	if level == LevelNone {
+		return true
+	}
+	if !s.node.IsLeader() {
+		http.Error(w, fmt.Sprintf("node is not leader, required for %s consistency", level), http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+type execResult struct {
+	LastInsertID int64  `json:"last_insert_id,omitempty"`
+	RowsAffected int64  `json:"rows_affected,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func (s *Server) handleExecute(w http.ResponseWriter, r *http.Request) {
+	statements, err := readStatements(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !s.enforceConsistency(w, r) {
+		return
+	}
+
+	results := make([]execResult, len(statements))
+	for i, st := range statements {
+		res, err := s.db.ExecContext(r.Context(), st.SQL, st.Args...)
+		if err != nil {
+			results[i] = execResult{Error: err.Error()}
+			continue
+		}
+		id, _ := res.LastInsertId()
+		n, _ := res.RowsAffected()
+		results[i] = execResult{LastInsertID: id, RowsAffected: n}
+	}
+
+	writeJSON(w, map[string]interface{}{"results": results})
+}
+
+type queryResult struct {
+	Columns []string        `json:"columns,omitempty"`
+	Values  [][]interface{} `json:"values,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request) {
+	statements, err := readStatements(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.enforceConsistency(w, r) {
+		return
+	}
+
+	results := make([]queryResult, len(statements))
+	for i, st := range statements {
+		result, err := s.runQuery(r.Context(), st)
+		if err != nil {
+			results[i] = queryResult{Error: err.Error()}
+			continue
+		}
+		results[i] = result
+	}
+
+	writeJSON(w, map[string]interface{}{"results": results})
+}
+
+func (s *Server) runQuery(ctx context.Context, st statement) (queryResult, error) {
+	rows, err := s.db.QueryContext(ctx, st.SQL, st.Args...)
+	if err != nil {
+		return queryResult{}, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return queryResult{}, err
+	}
+
+	var values [][]interface{}
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		scan := make([]interface{}, len(columns))
+		for i := range row {
+			scan[i] = &row[i]
+		}
+		if err := rows.Scan(scan...); err != nil {
+			return queryResult{}, err
+		}
+		values = append(values, row)
+	}
+	if err := rows.Err(); err != nil {
+		return queryResult{}, err
+	}
+
+	return queryResult{Columns: columns, Values: values}, nil
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	params := s.node.GetSnapshotParams()
+	writeJSON(w, map[string]interface{}{
+		"bind_address":   s.node.GetBindAddress(),
+		"failure_domain": s.node.GetFailureDomain(),
+		"leader":         s.node.IsLeader(),
+		"role":           s.node.GetRole(),
+		"snapshot_params": map[string]uint64{
+			"threshold": params.Threshold,
+			"trailing":  params.Trailing,
+		},
+	})
+}
+
+func (s *Server) handleNodes(w http.ResponseWriter, r *http.Request) {
+	cluster := s.node.GetClusterInfo()
+	nodes := make([]map[string]interface{}, len(cluster))
+	for i, info := range cluster {
+		nodes[i] = map[string]interface{}{
+			"id":      info.ID,
+			"address": info.Address,
+			"role":    info.Role,
+		}
+	}
+	writeJSON(w, map[string]interface{}{"nodes": nodes})
+}
+
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	// A node that can still answer GetBindAddress is alive; this doesn't
+	// guarantee it currently holds leadership, only that the process is
+	// up and the node hasn't been closed.
+	if s.node.GetBindAddress() == "" {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
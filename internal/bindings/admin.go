@@ -0,0 +1,46 @@
+//go:build !purego
+
+package bindings
+
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// TransferLeadership asks raft to hand leadership over to the node with the
+// given ID. It is a no-op error if this node isn't currently leader.
+func (s *Node) TransferLeadership(id uint64) error {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	cid := C.dqlite_node_id(id)
+	if rc := C.dqlite_node_transfer_leadership(server, cid); rc != 0 {
+		return wrapErr(fmt.Sprintf("transfer leadership to %d", id), rc, server)
+	}
+	return nil
+}
+
+// Freeze makes the leader stop accepting new log entries, so writes
+// cluster-wide are rejected until Unfreeze is called. It is used by the
+// admin service to pause a cluster for maintenance.
+func (s *Node) Freeze() error {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	if rc := C.dqlite_node_freeze(server); rc != 0 {
+		return wrapErr("freeze", rc, server)
+	}
+	statusMu.Lock()
+	nodeFrozen[server] = true
+	statusMu.Unlock()
+	return nil
+}
+
+// Unfreeze resumes accepting new log entries after a prior Freeze.
+func (s *Node) Unfreeze() error {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	if rc := C.dqlite_node_unfreeze(server); rc != 0 {
+		return wrapErr("unfreeze", rc, server)
+	}
+	statusMu.Lock()
+	nodeFrozen[server] = false
+	statusMu.Unlock()
+	return nil
+}
@@ -0,0 +1,315 @@
+package bindings
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// DialerConfig configures NewPooledTLSDialer.
+type DialerConfig struct {
+	// TLS, if non-nil, is used to upgrade every connection the dialer opens.
+	TLS *tls.Config
+
+	// InitialPoolSize is how many idle, already-dialed (and, with TLS,
+	// already-handshaked) connections per address the pool keeps primed
+	// in the background, refilled as they're handed out. Defaults to 4.
+	InitialPoolSize int
+
+	// MaxPoolSize bounds how many idle connections per address the pool will
+	// keep around. Defaults to 64.
+	MaxPoolSize int
+
+	// IdleTimeout is how long an idle pooled connection is kept before it is
+	// closed and evicted. Defaults to 30 seconds.
+	IdleTimeout time.Duration
+
+	// ConnectTimeout bounds how long dialing a new connection may take.
+	// Defaults to 5 seconds.
+	ConnectTimeout time.Duration
+}
+
+func (c DialerConfig) withDefaults() DialerConfig {
+	if c.InitialPoolSize == 0 {
+		c.InitialPoolSize = 4
+	}
+	if c.MaxPoolSize == 0 {
+		c.MaxPoolSize = 64
+	}
+	if c.IdleTimeout == 0 {
+		c.IdleTimeout = 30 * time.Second
+	}
+	if c.ConnectTimeout == 0 {
+		c.ConnectTimeout = 5 * time.Second
+	}
+	return c
+}
+
+// pooledConn wraps a pooled net.Conn with the time it was returned to the
+// pool, so idle ones can be evicted after DialerConfig.IdleTimeout.
+type pooledConn struct {
+	conn    net.Conn
+	idledAt time.Time
+}
+
+// connPool keeps a bounded per-address free-list of already-handshaked
+// connections, modeled on the initial=4/max=64 pattern used by rqlite's
+// cluster client.
+//
+// The cgo backend's connToSocket hands every connection off to C/raft for
+// the life of a single peer connection and never returns one via Close
+// (see pooledDialerConn.Close), so a free-list that only ever grows on
+// Close would stay permanently empty on that path. To actually amortize
+// dial+handshake cost there, get also triggers a background refill that
+// tops the address's free-list back up to InitialPoolSize using dialRaw,
+// independently of anything being returned.
+type connPool struct {
+	config  DialerConfig
+	dialRaw func(ctx context.Context, address string) (net.Conn, error)
+
+	mu        sync.Mutex
+	free      map[string][]pooledConn
+	refilling map[string]bool
+}
+
+func newConnPool(config DialerConfig, dialRaw func(ctx context.Context, address string) (net.Conn, error)) *connPool {
+	return &connPool{
+		config:    config,
+		dialRaw:   dialRaw,
+		free:      make(map[string][]pooledConn),
+		refilling: make(map[string]bool),
+	}
+}
+
+func (p *connPool) get(address string) net.Conn {
+	p.mu.Lock()
+	conns := p.free[address]
+	now := time.Now()
+	var conn net.Conn
+	for len(conns) > 0 {
+		last := len(conns) - 1
+		pc := conns[last]
+		conns = conns[:last]
+		if now.Sub(pc.idledAt) > p.config.IdleTimeout {
+			pc.conn.Close()
+			continue
+		}
+		conn = pc.conn
+		break
+	}
+	p.free[address] = conns
+	p.mu.Unlock()
+
+	p.prewarm(address)
+	return conn
+}
+
+func (p *connPool) put(address string, conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	conns := p.free[address]
+	if len(conns) >= p.config.MaxPoolSize {
+		conn.Close()
+		return
+	}
+	p.free[address] = append(conns, pooledConn{conn: conn, idledAt: time.Now()})
+}
+
+// prewarm dials fresh connections for address in the background until its
+// free-list holds InitialPoolSize of them, so the next caller for that
+// address gets a pool hit instead of paying for a dial (and, with TLS, a
+// handshake) inline. It's a no-op if a refill for address is already in
+// flight or the free-list is already at InitialPoolSize.
+func (p *connPool) prewarm(address string) {
+	p.mu.Lock()
+	if p.refilling[address] || len(p.free[address]) >= p.config.InitialPoolSize {
+		p.mu.Unlock()
+		return
+	}
+	p.refilling[address] = true
+	p.mu.Unlock()
+
+	go func() {
+		defer func() {
+			p.mu.Lock()
+			p.refilling[address] = false
+			p.mu.Unlock()
+		}()
+		for {
+			p.mu.Lock()
+			short := p.config.InitialPoolSize - len(p.free[address])
+			p.mu.Unlock()
+			if short <= 0 {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), p.config.ConnectTimeout)
+			conn, err := p.dialRaw(ctx, address)
+			cancel()
+			if err != nil {
+				return
+			}
+			p.put(address, conn)
+		}
+	}()
+}
+
+// NewPooledTLSDialer returns a protocol.DialFunc that keeps a bounded pool of
+// already-dialed (and, if cfg.TLS is set, already-handshaked) connections per
+// address, handing them out to raft without repeating the TCP+TLS handshake.
+// On a pool miss it transparently falls back to dialing a fresh connection,
+// and kicks off a background refill so the address is primed for next time
+// (see connPool.prewarm).
+func NewPooledTLSDialer(cfg DialerConfig) protocol.DialFunc {
+	cfg = cfg.withDefaults()
+
+	dialRaw := func(ctx context.Context, address string) (net.Conn, error) {
+		dialCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+		defer cancel()
+
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(dialCtx, "tcp", address)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.TLS != nil {
+			tlsConn := tls.Client(conn, cfg.TLS)
+			if err := tlsConn.HandshakeContext(dialCtx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			// connToSocket needs a plain TCP fd to hand to the C/raft
+			// layer, which a *tls.Conn can't expose, so bridge the
+			// encrypted socket onto a loopback one carrying the
+			// decrypted bytes.
+			conn, err = bridgeTLS(tlsConn)
+			if err != nil {
+				tlsConn.Close()
+				return nil, err
+			}
+		}
+
+		return conn, nil
+	}
+
+	pool := newConnPool(cfg, dialRaw)
+
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		if conn := pool.get(address); conn != nil {
+			return &pooledDialerConn{Conn: conn, pool: pool, address: address}, nil
+		}
+
+		conn, err := dialRaw(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pooledDialerConn{Conn: conn, pool: pool, address: address}, nil
+	}
+}
+
+// bridgeTLS splices a TLS connection onto a loopback TCP connection, so that
+// callers needing a raw file descriptor (connToSocket) can still be handed
+// one even though encryption is in play.
+func bridgeTLS(tlsConn net.Conn) (net.Conn, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+
+	accepted := make(chan net.Conn, 1)
+	acceptErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			acceptErr <- err
+			return
+		}
+		accepted <- conn
+	}()
+
+	local, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var remote net.Conn
+	select {
+	case remote = <-accepted:
+	case err := <-acceptErr:
+		local.Close()
+		return nil, err
+	}
+
+	go spliceConns(tlsConn, remote)
+
+	return local, nil
+}
+
+// spliceConns copies bytes in both directions until both sides are done,
+// then closes both. A real raft connection is often quiet in one direction
+// for long stretches, so returning as soon as the first io.Copy stops would
+// tear down an otherwise-healthy bridge; wait for both.
+func spliceConns(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(a, b)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(b, a)
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+	a.Close()
+	b.Close()
+}
+
+// pooledDialerConn returns its underlying net.Conn to the pool instead of
+// closing it - but only if it's never been handed off via File(). On the cgo
+// backend the caller of a protocol.DialFunc is connectWithDial/connToSocket,
+// which dups the fd out for raft/C to own for the life of that peer
+// connection and then calls Close(); at that point the conn has already been
+// fully consumed and must be actually closed, not recycled, or a later
+// pool.get could hand the same socket out to a second, unrelated raft
+// connection. The purego backend's transport never calls File(), so every
+// Close there returns the conn to the pool.
+type pooledDialerConn struct {
+	net.Conn
+	pool    *connPool
+	address string
+
+	mu        sync.Mutex
+	handedOff bool
+}
+
+func (c *pooledDialerConn) Close() error {
+	c.mu.Lock()
+	handedOff := c.handedOff
+	c.mu.Unlock()
+	if handedOff {
+		return c.Conn.Close()
+	}
+	c.pool.put(c.address, c.Conn)
+	return nil
+}
+
+// File lets connToSocket (cgo backend only) extract the underlying
+// descriptor of a pooled connection, same as it would for a fresh, unpooled
+// one. Calling it marks the conn as handed off, so the subsequent Close
+// actually closes the socket instead of returning it to the pool.
+func (c *pooledDialerConn) File() (*os.File, error) {
+	c.mu.Lock()
+	c.handedOff = true
+	c.mu.Unlock()
+	return c.Conn.(interface{ File() (*os.File, error) }).File()
+}
@@ -0,0 +1,119 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// tcpPipe returns the two ends of a real loopback TCP connection, so tests
+// can exercise File() (which needs an *os.File-capable net.Conn) and
+// half-close (which net.Pipe doesn't support).
+func tcpPipe(t *testing.T) (client, server net.Conn) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	client, err = net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	server = <-accepted
+	t.Cleanup(func() { server.Close() })
+	return client, server
+}
+
+// TestPooledDialerConnHandoff guards the fix in caf4d65: a conn that's been
+// handed off via File() (the path connToSocket takes for the cgo backend)
+// must be actually closed by Close, never recycled, since by then raft/C
+// owns a dup of its fd for the life of that peer connection. A conn that's
+// never been handed off should instead go back to the pool, so the next
+// get() for that address is a hit instead of a fresh dial.
+func TestPooledDialerConnHandoff(t *testing.T) {
+	cfg := DialerConfig{MaxPoolSize: 4, IdleTimeout: time.Minute}
+	pool := newConnPool(cfg, func(ctx context.Context, address string) (net.Conn, error) {
+		return nil, errors.New("prewarm disabled for this test")
+	})
+
+	handedOffClient, _ := tcpPipe(t)
+	handedOff := &pooledDialerConn{Conn: handedOffClient, pool: pool, address: "a"}
+	if _, err := handedOff.File(); err != nil {
+		t.Fatalf("File: %v", err)
+	}
+	if err := handedOff.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if conn := pool.get("a"); conn != nil {
+		t.Fatal("get: got a pooled conn after a handed-off Close, want none")
+	}
+	if _, err := handedOffClient.Write([]byte("x")); err == nil {
+		t.Fatal("Write: handed-off conn should have been closed by Close, not pooled")
+	}
+
+	keptClient, _ := tcpPipe(t)
+	kept := &pooledDialerConn{Conn: keptClient, pool: pool, address: "a"}
+	if err := kept.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if conn := pool.get("a"); conn != keptClient {
+		t.Fatalf("get: got %v, want the conn returned by the non-handed-off Close", conn)
+	}
+}
+
+// TestSpliceConnsWaitsForBothDirections guards the fix in caf4d65: a bridge
+// must survive one direction finishing (e.g. a half-close) as long as the
+// other direction is still carrying traffic, instead of tearing both ends
+// down the moment the first io.Copy returns.
+func TestSpliceConnsWaitsForBothDirections(t *testing.T) {
+	aClient, aConn := tcpPipe(t)
+	bClient, bConn := tcpPipe(t)
+
+	go spliceConns(aConn, bConn)
+
+	// Half-close aClient's write side: aConn's read hits EOF almost
+	// immediately, finishing the a->b copy direction (io.Copy(b, a)) while
+	// the opposite direction (b->a, i.e. data written on bClient reaching
+	// aClient) is still healthy.
+	if err := aClient.(*net.TCPConn).CloseWrite(); err != nil {
+		t.Fatalf("CloseWrite: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if _, err := bClient.Write([]byte("hello")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	buf := make([]byte, 5)
+	aClient.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := io.ReadFull(aClient, buf); err != nil {
+		t.Fatalf("ReadFull: %v (bridge was torn down after only one direction finished)", err)
+	}
+	if string(buf) != "hello" {
+		t.Fatalf("got %q, want %q", buf, "hello")
+	}
+
+	// Finish the other direction too, so spliceConns can return and close
+	// both bridged ends.
+	if err := bClient.(*net.TCPConn).CloseWrite(); err != nil {
+		t.Fatalf("CloseWrite: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err := aConn.Write([]byte("x")); err == nil {
+		t.Fatal("Write: spliceConns should have closed aConn once both directions finished")
+	}
+}
@@ -0,0 +1,123 @@
+//go:build !purego
+
+package bindings
+
+import "C"
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// NodeError is returned by every Node method that fails on the C side. It
+// keeps the operation name, the raw return code, and the raw message from
+// dqlite_node_errmsg around so callers can program against Code/Is instead
+// of parsing Message.
+type NodeError struct {
+	Op      string
+	Code    int
+	Message string
+	Wrapped error
+}
+
+func (e *NodeError) Error() string {
+	if e.Message == "" {
+		return e.Op + ": error code " + strconv.Itoa(e.Code)
+	}
+	return e.Op + ": " + e.Message
+}
+
+func (e *NodeError) Unwrap() error {
+	return e.Wrapped
+}
+
+// Is reports whether target is a *NodeError sentinel with the same Code, so
+// callers can do errors.Is(err, bindings.ErrNotLeader).
+func (e *NodeError) Is(target error) bool {
+	other, ok := target.(*NodeError)
+	if !ok {
+		return false
+	}
+	return other.Code == e.Code
+}
+
+// Sentinel errors for the C-side failure modes callers most often need to
+// tell apart. Match with errors.Is, e.g. errors.Is(err, bindings.ErrNotLeader).
+var (
+	ErrAddressInUse       = &NodeError{Code: codeAddressInUse}
+	ErrRecoveryConflict   = &NodeError{Code: codeRecoveryConflict}
+	ErrNotLeader          = &NodeError{Code: codeNotLeader}
+	ErrSnapshotInProgress = &NodeError{Code: codeSnapshotInProgress}
+)
+
+// Internal codes. These don't need to (and don't) match libdqlite's own
+// integer return codes: wrapErr derives them from the return code where
+// dqlite exposes a discrete one, and otherwise falls back to sniffing
+// dqlite_node_errmsg, since several of these failure modes are only ever
+// reported as text.
+const (
+	codeUnknown = iota
+	codeAddressInUse
+	codeRecoveryConflict
+	codeNotLeader
+	codeSnapshotInProgress
+)
+
+// wrapErr turns a C return code (plus, where available, the node's error
+// message) into a *NodeError, classifying it against the sentinels above
+// when possible.
+func wrapErr(op string, rc C.int, server *C.dqlite_node) error {
+	if rc == 0 {
+		return nil
+	}
+
+	var message string
+	if server != nil {
+		message = C.GoString(C.dqlite_node_errmsg(server))
+	}
+
+	code := codeFromRaftRC(rc)
+	if code == codeUnknown {
+		code = codeFromMessage(message)
+	}
+
+	// Wrapped carries the raw dqlite_node_errmsg text as a plain error, so
+	// callers that need the unclassified message - say, to log it alongside
+	// the coarser Code - can reach it with errors.Unwrap instead of
+	// re-deriving it from Error()'s "op: message" formatting.
+	var wrapped error
+	if message != "" {
+		wrapped = errors.New(message)
+	}
+
+	return &NodeError{Op: op, Code: code, Message: message, Wrapped: wrapped}
+}
+
+func codeFromRaftRC(rc C.int) int {
+	switch rc {
+	case C.RAFT_NOTLEADER:
+		return codeNotLeader
+	case C.RAFT_DUPLICATEADDRESS:
+		return codeAddressInUse
+	case C.RAFT_BUSY:
+		return codeSnapshotInProgress
+	default:
+		return codeUnknown
+	}
+}
+
+func codeFromMessage(message string) int {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "address already in use"), strings.Contains(lower, "address in use"):
+		return codeAddressInUse
+	case strings.Contains(lower, "not leader") || strings.Contains(lower, "not the leader"):
+		return codeNotLeader
+	case strings.Contains(lower, "snapshot") && strings.Contains(lower, "progress"):
+		return codeSnapshotInProgress
+	case strings.Contains(lower, "recover"):
+		return codeRecoveryConflict
+	default:
+		return codeUnknown
+	}
+}
@@ -0,0 +1,60 @@
+package bindings_test
+
+import (
+	"testing"
+
+	"github.com/canonical/go-dqlite/internal/bindings"
+)
+
+// TestNodeContract exercises the exported Node surface that's identical
+// across the cgo and purego backends. It carries no build tag itself, so
+// running `go test ./...` proves the cgo backend satisfies the contract and
+// running it again with -tags purego proves the purego one does too.
+func TestNodeContract(t *testing.T) {
+	dir := t.TempDir()
+	address := "127.0.0.1:9001"
+
+	node, err := bindings.NewNode(bindings.GenerateID(address), address, dir)
+	if err != nil {
+		t.Fatalf("NewNode: %v", err)
+	}
+	defer node.Close()
+
+	if err := node.SetBindAddress(address); err != nil {
+		t.Fatalf("SetBindAddress: %v", err)
+	}
+	if got := node.GetBindAddress(); got != address {
+		t.Fatalf("GetBindAddress: got %q, want %q", got, address)
+	}
+
+	params := bindings.SnapshotParams{Threshold: 8, Trailing: 16}
+	if err := node.SetSnapshotParams(params); err != nil {
+		t.Fatalf("SetSnapshotParams: %v", err)
+	}
+	if got := node.GetSnapshotParams(); got != params {
+		t.Fatalf("GetSnapshotParams: got %+v, want %+v", got, params)
+	}
+
+	if err := node.SetFailureDomain(3); err != nil {
+		t.Fatalf("SetFailureDomain: %v", err)
+	}
+	if got := node.GetFailureDomain(); got != 3 {
+		t.Fatalf("GetFailureDomain: got %d, want 3", got)
+	}
+
+	if node.Frozen() {
+		t.Fatal("Frozen: got true before Freeze")
+	}
+	if err := node.Freeze(); err != nil {
+		t.Fatalf("Freeze: %v", err)
+	}
+	if !node.Frozen() {
+		t.Fatal("Frozen: got false after Freeze")
+	}
+	if err := node.Unfreeze(); err != nil {
+		t.Fatalf("Unfreeze: %v", err)
+	}
+	if node.Frozen() {
+		t.Fatal("Frozen: got true after Unfreeze")
+	}
+}
@@ -1,3 +1,5 @@
+//go:build !purego
+
 package bindings
 
 import "C"
@@ -15,11 +17,6 @@ import (
 
 type Node C.dqlite_node
 
-type SnapshotParams struct {
-	Threshold uint64
-	Trailing  uint64
-}
-
 // Initializes state.
 func init() {
 	// FIXME: ignore SIGPIPE, see https://github.com/joyent/libuv/issues/1254
@@ -52,10 +49,13 @@ func NewNode(id uint64, address string, dir string) (*Node, error) {
 	defer C.free(unsafe.Pointer(cdir))
 
 	if rc := C.dqlite_node_create(cid, caddress, cdir, &server); rc != 0 {
-		errmsg := C.GoString(C.dqlite_node_errmsg(server))
-		return nil, fmt.Errorf("%s", errmsg)
+		return nil, wrapErr("create node", rc, server)
 	}
 
+	statusMu.Lock()
+	nodeSelfID[server] = id
+	statusMu.Unlock()
+
 	return (*Node)(unsafe.Pointer(server)), nil
 }
 
@@ -65,9 +65,26 @@ func (s *Node) SetDialFunc(dial protocol.DialFunc) error {
 	defer connectLock.Unlock()
 	connectIndex++
 	connectRegistry[connectIndex] = dial
+	dialTimeoutRegistry[connectIndex] = defaultDialTimeout
+	nodeDialHandle[server] = connectIndex
 	if rc := C.configConnectFunc(server, connectIndex); rc != 0 {
-		return fmt.Errorf("failed to set connect func")
+		return wrapErr("set dial func", rc, server)
+	}
+	return nil
+}
+
+// SetDialTimeout overrides the default 5 second timeout used when raft dials
+// a peer through the callback registered via SetDialFunc. It must be called
+// after SetDialFunc.
+func (s *Node) SetDialTimeout(timeout time.Duration) error {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	connectLock.Lock()
+	defer connectLock.Unlock()
+	handle, ok := nodeDialHandle[server]
+	if !ok {
+		return fmt.Errorf("no dial function set")
 	}
+	dialTimeoutRegistry[handle] = timeout
 	return nil
 }
 
@@ -76,7 +93,7 @@ func (s *Node) SetBindAddress(address string) error {
 	caddress := C.CString(address)
 	defer C.free(unsafe.Pointer(caddress))
 	if rc := C.dqlite_node_set_bind_address(server, caddress); rc != 0 {
-		return fmt.Errorf("failed to set bind address %q: %d", address, rc)
+		return wrapErr(fmt.Sprintf("set bind address %q", address), rc, server)
 	}
 	return nil
 }
@@ -85,7 +102,7 @@ func (s *Node) SetNetworkLatency(nanoseconds uint64) error {
 	server := (*C.dqlite_node)(unsafe.Pointer(s))
 	cnanoseconds := C.nanoseconds_t(nanoseconds)
 	if rc := C.dqlite_node_set_network_latency(server, cnanoseconds); rc != 0 {
-		return fmt.Errorf("failed to set network latency")
+		return wrapErr("set network latency", rc, server)
 	}
 	return nil
 }
@@ -95,8 +112,11 @@ func (s *Node) SetSnapshotParams(params SnapshotParams) error {
 	cthreshold := C.unsigned(params.Threshold)
 	ctrailing := C.unsigned(params.Trailing)
 	if rc := C.dqlite_node_set_snapshot_params(server, cthreshold, ctrailing); rc != 0 {
-		return fmt.Errorf("failed to set snapshot params")
+		return wrapErr("set snapshot params", rc, server)
 	}
+	statusMu.Lock()
+	nodeSnapshotParams[server] = params
+	statusMu.Unlock()
 	return nil
 }
 
@@ -104,8 +124,11 @@ func (s *Node) SetFailureDomain(code uint64) error {
 	server := (*C.dqlite_node)(unsafe.Pointer(s))
 	ccode := C.failure_domain_t(code)
 	if rc := C.dqlite_node_set_failure_domain(server, ccode); rc != 0 {
-		return fmt.Errorf("set failure domain: %d", rc)
+		return wrapErr("set failure domain", rc, server)
 	}
+	statusMu.Lock()
+	nodeFailureDomain[server] = code
+	statusMu.Unlock()
 	return nil
 }
 
@@ -117,8 +140,7 @@ func (s *Node) GetBindAddress() string {
 func (s *Node) Start() error {
 	server := (*C.dqlite_node)(unsafe.Pointer(s))
 	if rc := C.dqlite_node_start(server); rc != 0 {
-		errmsg := C.GoString(C.dqlite_node_errmsg(server))
-		return fmt.Errorf("%s", errmsg)
+		return wrapErr("start node", rc, server)
 	}
 	return nil
 }
@@ -126,7 +148,7 @@ func (s *Node) Start() error {
 func (s *Node) Stop() error {
 	server := (*C.dqlite_node)(unsafe.Pointer(s))
 	if rc := C.dqlite_node_stop(server); rc != 0 {
-		return fmt.Errorf("task stopped with error code %d", rc)
+		return wrapErr("stop node", rc, server)
 	}
 	return nil
 }
@@ -134,6 +156,7 @@ func (s *Node) Stop() error {
 // Close the server releasing all used resources.
 func (s *Node) Close() {
 	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	stopSnapshotPolicy(server)
 	C.dqlite_node_destroy(server)
 }
 
@@ -159,8 +182,11 @@ func (s *Node) RecoverExt(cluster []protocol.NodeInfo) error {
 		C.setInfo(infos, C.unsigned(i), cid, caddress, crole)
 	}
 	if rc := C.dqlite_node_recover_ext(server, infos, n); rc != 0 {
-		return fmt.Errorf("recover failed with error code %d", rc)
+		return wrapErr("recover cluster", rc, server)
 	}
+	statusMu.Lock()
+	nodeCluster[server] = append([]protocol.NodeInfo(nil), cluster...)
+	statusMu.Unlock()
 	return nil
 }
 
@@ -202,10 +228,13 @@ type fileConn interface {
 //export connectWithDial
 func connectWithDial(handle C.uintptr_t, address *C.char, fd *C.int) C.int {
 	connectLock.Lock()
-	defer connectLock.Unlock()
 	dial := connectRegistry[handle]
-	// TODO: make timeout customizable.
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	timeout := dialTimeoutRegistry[handle]
+	connectLock.Unlock()
+	if timeout == 0 {
+		timeout = defaultDialTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	conn, err := dial(ctx, C.GoString(address))
 	if err != nil {
@@ -219,8 +248,14 @@ func connectWithDial(handle C.uintptr_t, address *C.char, fd *C.int) C.int {
 	return C.int(0)
 }
 
+// defaultDialTimeout is used by connectWithDial when a node hasn't called
+// SetDialTimeout.
+const defaultDialTimeout = 5 * time.Second
+
 // Use handles to avoid passing Go pointers to C.
 var connectRegistry = make(map[C.uintptr_t]protocol.DialFunc)
+var dialTimeoutRegistry = make(map[C.uintptr_t]time.Duration)
+var nodeDialHandle = make(map[*C.dqlite_node]C.uintptr_t)
 var connectIndex C.uintptr_t = 100
 var connectLock = sync.Mutex{}
 
@@ -0,0 +1,555 @@
+//go:build purego
+
+package bindings
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb/v2"
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// snapshotPollInterval is how often the goroutine started by
+// SetSnapshotPolicy re-evaluates the policy against fresh metrics.
+const snapshotPollInterval = 1 * time.Second
+
+// Node is the purego counterpart of the cgo Node: it drives a Go raft.Raft
+// instance over a wazero-embedded SQLite engine instead of linking against
+// libdqlite/libraft/libsqlite3. Its exported methods are identical to the cgo
+// Node's, so callers of Node methods don't need to know which backend was
+// compiled in. The NodeError type, its sentinel errors and ErrNodeStopped are
+// defined only in the cgo build.
+type Node struct {
+	mu             sync.Mutex
+	id             uint64
+	address        string
+	bindAddress    string
+	dir            string
+	dial           protocol.DialFunc
+	dialTimeout    time.Duration
+	networkLatency time.Duration
+	snapshotParams SnapshotParams
+	failureDomain  uint64
+	cluster        []protocol.NodeInfo
+	frozen         atomic.Bool
+	snapshotStop   chan struct{}
+
+	raft      *raft.Raft
+	transport *dialTransport
+	fsm       *sqliteFSM
+}
+
+// ConfigSingleThread is a no-op under the purego backend: the wazero-embedded
+// SQLite engine is always driven from a single goroutine per connection.
+func ConfigSingleThread() error {
+	return nil
+}
+
+// ConfigMultiThread is a no-op under the purego backend, see ConfigSingleThread.
+func ConfigMultiThread() error {
+	return nil
+}
+
+// NewNode creates a new Node instance backed by a wazero-embedded SQLite
+// engine and a Go raft implementation.
+func NewNode(id uint64, address string, dir string) (*Node, error) {
+	path := filepath.Join(dir, "db.sqlite")
+	conn, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite engine: %w", err)
+	}
+
+	s := &Node{
+		id:          id,
+		address:     address,
+		bindAddress: address,
+		dir:         dir,
+		snapshotParams: SnapshotParams{
+			Threshold: 1024,
+			Trailing:  4096,
+		},
+	}
+
+	fsm, err := newSQLiteFSM(conn, path, &s.frozen)
+	if err != nil {
+		return nil, fmt.Errorf("create fsm: %w", err)
+	}
+	s.fsm = fsm
+
+	return s, nil
+}
+
+func (s *Node) SetDialFunc(dial protocol.DialFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dial = dial
+	return nil
+}
+
+// SetDialTimeout overrides the default 5 second timeout used when raft dials
+// a peer through the callback registered via SetDialFunc.
+func (s *Node) SetDialTimeout(timeout time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dialTimeout = timeout
+	return nil
+}
+
+func (s *Node) SetBindAddress(address string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.raft != nil {
+		return fmt.Errorf("cannot change bind address after start")
+	}
+	s.bindAddress = address
+	return nil
+}
+
+func (s *Node) SetNetworkLatency(nanoseconds uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.networkLatency = time.Duration(nanoseconds)
+	return nil
+}
+
+func (s *Node) SetSnapshotParams(params SnapshotParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshotParams = params
+	if s.raft != nil {
+		s.raft.ReloadConfig(s.raftConfig())
+	}
+	return nil
+}
+
+func (s *Node) SetFailureDomain(code uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failureDomain = code
+	return nil
+}
+
+func (s *Node) GetBindAddress() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bindAddress
+}
+
+// GetSnapshotParams returns the SnapshotParams last set via SetSnapshotParams,
+// or the defaults set by NewNode (Threshold 1024, Trailing 4096) if none have
+// been set yet.
+func (s *Node) GetSnapshotParams() SnapshotParams {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshotParams
+}
+
+// GetFailureDomain returns the failure domain code last set via
+// SetFailureDomain.
+func (s *Node) GetFailureDomain() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.failureDomain
+}
+
+// GetID returns this node's own ID, as passed to NewNode.
+func (s *Node) GetID() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.id
+}
+
+// GetClusterInfo returns the cluster membership last pushed down via Recover
+// or RecoverExt.
+func (s *Node) GetClusterInfo() []protocol.NodeInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]protocol.NodeInfo, len(s.cluster))
+	copy(out, s.cluster)
+	return out
+}
+
+// GetRole returns this node's role within the cluster membership last
+// pushed down via Recover or RecoverExt, or protocol.Voter if this node
+// isn't in that membership yet (e.g. Recover hasn't run).
+func (s *Node) GetRole() protocol.Role {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, info := range s.cluster {
+		if info.ID == s.id {
+			return info.Role
+		}
+	}
+	return protocol.Voter
+}
+
+// IsLeader reports whether this node currently believes itself to be the
+// raft leader.
+func (s *Node) IsLeader() bool {
+	s.mu.Lock()
+	r := s.raft
+	s.mu.Unlock()
+	return r != nil && r.State() == raft.Leader
+}
+
+// CaughtUp reports whether this node is fit to serve: the leader (always
+// caught up), or a follower whose applied log index is within maxLag
+// entries of raft's last known log index and that has heard from the
+// leader within maxSinceContact.
+//
+// The lag check alone can't tell a caught-up follower from one that's been
+// partitioned from the leader: once AppendEntries stops arriving, both
+// last_log_index and applied_index stop advancing together, so their gap
+// stays flat instead of growing. raft.Raft.LastContact(), the idiom this
+// library exposes for exactly this check, catches that case.
+func (s *Node) CaughtUp(maxLag uint64, maxSinceContact time.Duration) bool {
+	s.mu.Lock()
+	r := s.raft
+	s.mu.Unlock()
+	if r == nil {
+		return false
+	}
+	if r.State() == raft.Leader {
+		return true
+	}
+	if time.Since(r.LastContact()) > maxSinceContact {
+		return false
+	}
+	stats := r.Stats()
+	last, _ := strconv.ParseUint(stats["last_log_index"], 10, 64)
+	applied, _ := strconv.ParseUint(stats["applied_index"], 10, 64)
+	if last < applied {
+		return true
+	}
+	return last-applied <= maxLag
+}
+
+// SetSnapshotPolicy adds a policy evaluated against live raft metrics on top
+// of raft's own threshold/trailing snapshot trigger, which stays active with
+// whatever SnapshotParams are in effect. It starts a background
+// goroutine that polls raft.Raft.Stats() every snapshotPollInterval and
+// calls raft.Raft.Snapshot() whenever the policy says to snapshot now.
+// Calling SetSnapshotPolicy again stops the previous goroutine and starts a
+// new one with the new policy; Close stops it for good.
+//
+// raft.Raft doesn't expose per-follower match index outside the library, so
+// SnapshotState.FollowerLag is always empty on this backend: policies that
+// rely on it fall back to their LogEntries/MaxLogEntries path.
+func (s *Node) SetSnapshotPolicy(policy SnapshotPolicy) error {
+	s.mu.Lock()
+	if s.snapshotStop != nil {
+		close(s.snapshotStop)
+	}
+	stop := make(chan struct{})
+	s.snapshotStop = stop
+	s.mu.Unlock()
+
+	go s.runSnapshotPolicy(policy, stop)
+	return nil
+}
+
+func (s *Node) runSnapshotPolicy(policy SnapshotPolicy, stop chan struct{}) {
+	ticker := time.NewTicker(snapshotPollInterval)
+	defer ticker.Stop()
+
+	lastSnapshot := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
+		r := s.raft
+		failureDomain := s.failureDomain
+		params := s.snapshotParams
+		s.mu.Unlock()
+		if r == nil {
+			continue
+		}
+
+		state := SnapshotState{
+			LogEntries:        raftLogEntries(r.Stats()),
+			SinceLastSnapshot: time.Since(lastSnapshot),
+			FailureDomain:     failureDomain,
+			Params:            params,
+		}
+		shouldSnapshot, newParams := policy.ShouldSnapshot(state)
+		if newParams != (SnapshotParams{}) {
+			_ = s.SetSnapshotParams(newParams)
+		}
+		if shouldSnapshot {
+			if err := r.Snapshot().Error(); err == nil {
+				lastSnapshot = time.Now()
+			}
+		}
+	}
+}
+
+// raftLogEntries returns how many log entries have been applied since the
+// last snapshot, derived from raft.Raft.Stats().
+func raftLogEntries(stats map[string]string) uint64 {
+	applied, _ := strconv.ParseUint(stats["applied_index"], 10, 64)
+	lastSnapshot, _ := strconv.ParseUint(stats["last_snapshot_index"], 10, 64)
+	if applied < lastSnapshot {
+		return 0
+	}
+	return applied - lastSnapshot
+}
+
+func (s *Node) raftConfig() raft.ReloadableConfig {
+	return raft.ReloadableConfig{
+		SnapshotThreshold: s.snapshotParams.Threshold,
+		TrailingLogs:      s.snapshotParams.Trailing,
+	}
+}
+
+// Start brings up the raft instance for this node. SetDialFunc must have
+// been called first, since raft's transport dials peers lazily on demand.
+func (s *Node) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dial == nil {
+		return fmt.Errorf("no dial function set")
+	}
+	if s.raft != nil {
+		return fmt.Errorf("node already started")
+	}
+
+	config := raft.DefaultConfig()
+	config.LocalID = raft.ServerID(fmt.Sprintf("%d", s.id))
+	config.SnapshotThreshold = s.snapshotParams.Threshold
+	config.TrailingLogs = s.snapshotParams.Trailing
+	if s.networkLatency > 0 {
+		config.HeartbeatTimeout = 10 * s.networkLatency
+		config.ElectionTimeout = 10 * s.networkLatency
+	}
+
+	store, err := boltdb.New(boltdb.Options{Path: filepath.Join(s.dir, "raft.db")})
+	if err != nil {
+		return fmt.Errorf("open log store: %w", err)
+	}
+
+	snapshots, err := raft.NewFileSnapshotStore(s.dir, 2, nil)
+	if err != nil {
+		return fmt.Errorf("open snapshot store: %w", err)
+	}
+
+	dialTimeout := s.dialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = 5 * time.Second
+	}
+	transport := newDialTransport(s.bindAddress, s.dial, dialTimeout)
+
+	r, err := raft.NewRaft(config, s.fsm, store, store, snapshots, transport)
+	if err != nil {
+		return fmt.Errorf("start raft: %w", err)
+	}
+
+	s.raft = r
+	s.transport = transport
+	return nil
+}
+
+func (s *Node) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.raft == nil {
+		return nil
+	}
+	if err := s.raft.Shutdown().Error(); err != nil {
+		return fmt.Errorf("task stopped with error: %w", err)
+	}
+	return s.transport.Close()
+}
+
+// Close the server releasing all used resources.
+func (s *Node) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snapshotStop != nil {
+		close(s.snapshotStop)
+		s.snapshotStop = nil
+	}
+	s.fsm.close()
+}
+
+// TransferLeadership asks raft to hand leadership over to the node with the
+// given ID. It is an error if this node isn't currently leader or if id
+// isn't part of the cluster last recovered via Recover/RecoverExt.
+func (s *Node) TransferLeadership(id uint64) error {
+	s.mu.Lock()
+	r := s.raft
+	cluster := s.cluster
+	s.mu.Unlock()
+
+	if r == nil {
+		return fmt.Errorf("node not started")
+	}
+	for _, info := range cluster {
+		if info.ID == id {
+			return r.LeadershipTransferToServer(raft.ServerID(fmt.Sprintf("%d", id)), raft.ServerAddress(info.Address)).Error()
+		}
+	}
+	return fmt.Errorf("transfer leadership: unknown node %d", id)
+}
+
+// Freeze flips a flag that sqliteFSM.Apply consults before applying a
+// committed entry to sqlite, so that writes cluster-wide are rejected until
+// Unfreeze is called - the purego equivalent of the cgo backend's C freeze
+// primitive. raft still commits and applies the log entry (there's no
+// raft-level freeze here), but Apply turns it into an error instead of
+// running it against the database.
+func (s *Node) Freeze() error {
+	s.frozen.Store(true)
+	return nil
+}
+
+// Unfreeze resumes accepting new log entries after a prior Freeze.
+func (s *Node) Unfreeze() error {
+	s.frozen.Store(false)
+	return nil
+}
+
+// Frozen reports whether Freeze has been called without a matching Unfreeze.
+func (s *Node) Frozen() bool {
+	return s.frozen.Load()
+}
+
+// Remark that Recover doesn't take the node role into account
+func (s *Node) Recover(cluster []protocol.NodeInfo) error {
+	for i := range cluster {
+		cluster[i].Role = protocol.Voter
+	}
+	return s.RecoverExt(cluster)
+}
+
+// RecoverExt has a similar purpose as `Recover` but takes the node role into account
+func (s *Node) RecoverExt(cluster []protocol.NodeInfo) error {
+	servers := make([]raft.Server, len(cluster))
+	for i, info := range cluster {
+		suffrage := raft.Nonvoter
+		if info.Role == protocol.Voter {
+			suffrage = raft.Voter
+		}
+		servers[i] = raft.Server{
+			ID:       raft.ServerID(fmt.Sprintf("%d", info.ID)),
+			Address:  raft.ServerAddress(info.Address),
+			Suffrage: suffrage,
+		}
+	}
+
+	store, err := boltdb.New(boltdb.Options{Path: filepath.Join(s.dir, "raft.db")})
+	if err != nil {
+		return fmt.Errorf("open log store: %w", err)
+	}
+	snapshots, err := raft.NewFileSnapshotStore(s.dir, 2, nil)
+	if err != nil {
+		return fmt.Errorf("open snapshot store: %w", err)
+	}
+
+	cfg := raft.Configuration{Servers: servers}
+	dialTimeout := s.dialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = 5 * time.Second
+	}
+	if err := raft.RecoverCluster(raft.DefaultConfig(), s.fsm, store, store, snapshots, newDialTransport(s.bindAddress, s.dial, dialTimeout), cfg); err != nil {
+		return fmt.Errorf("recover failed: %w", err)
+	}
+
+	s.mu.Lock()
+	s.cluster = append([]protocol.NodeInfo(nil), cluster...)
+	s.mu.Unlock()
+	return nil
+}
+
+// GenerateID generates a unique ID for a server.
+func GenerateID(address string) uint64 {
+	h := fnv64a(address)
+	if h == 0 {
+		h = 1
+	}
+	return h
+}
+
+func fnv64a(s string) uint64 {
+	const offset64 = 14695981039346656037
+	const prime64 = 1099511628211
+	h := uint64(offset64)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime64
+	}
+	return h
+}
+
+// dialTransport adapts a protocol.DialFunc, the same callback used by the
+// cgo backend's connectWithDial, into a raft.StreamLayer/raft.Transport so
+// the two backends share identical dialing semantics and SetDialFunc users
+// don't need a different callback shape per build tag.
+type dialTransport struct {
+	*raft.NetworkTransport
+	listener net.Listener
+}
+
+func newDialTransport(address string, dial protocol.DialFunc, timeout time.Duration) *dialTransport {
+	layer := &dialStreamLayer{address: address, dial: dial, timeout: timeout}
+	transport := raft.NewNetworkTransport(layer, 3, timeout, nil)
+	return &dialTransport{NetworkTransport: transport}
+}
+
+func (t *dialTransport) Close() error {
+	return t.NetworkTransport.Close()
+}
+
+type dialStreamLayer struct {
+	address  string
+	dial     protocol.DialFunc
+	timeout  time.Duration
+	listener net.Listener
+}
+
+func (l *dialStreamLayer) Dial(address raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return l.dial(ctx, string(address))
+}
+
+func (l *dialStreamLayer) Accept() (net.Conn, error) {
+	if l.listener == nil {
+		listener, err := net.Listen("tcp", l.address)
+		if err != nil {
+			return nil, err
+		}
+		l.listener = listener
+	}
+	return l.listener.Accept()
+}
+
+func (l *dialStreamLayer) Close() error {
+	if l.listener == nil {
+		return nil
+	}
+	return l.listener.Close()
+}
+
+func (l *dialStreamLayer) Addr() net.Addr {
+	if l.listener == nil {
+		return &net.TCPAddr{}
+	}
+	return l.listener.Addr()
+}
@@ -0,0 +1,85 @@
+package bindings
+
+import "time"
+
+// SnapshotState is a point-in-time read of the raft metrics a SnapshotPolicy
+// needs to decide whether to snapshot now. It is assembled by each backend
+// from whatever metrics that backend has available; fields a backend can't
+// populate are left at their zero value, and policies should treat a zero
+// FollowerLag as "unknown" rather than "no lag".
+type SnapshotState struct {
+	// LogEntries is the number of log entries applied since the last
+	// snapshot.
+	LogEntries uint64
+	// SinceLastSnapshot is how long ago the policy goroutine last triggered
+	// a snapshot, or, before its first one, how long ago SetSnapshotPolicy
+	// started it. Snapshots raft takes on its own threshold are not counted.
+	SinceLastSnapshot time.Duration
+	// FollowerLag maps the node ID of each member of the cluster last
+	// pushed down via Recover/RecoverExt to how many log entries behind the
+	// leader it is. Only populated on the leader, and only on backends that
+	// can read it back from raft.
+	FollowerLag map[uint64]uint64
+	// FailureDomain is the code last set via SetFailureDomain; policies can
+	// use it as a coarse signal of disk pressure.
+	FailureDomain uint64
+	// Params is the SnapshotParams currently in effect.
+	Params SnapshotParams
+}
+
+// SnapshotPolicy decides, given the current SnapshotState, whether a node
+// should snapshot right now and which SnapshotParams should apply going
+// forward. SetSnapshotPolicy polls it on a fixed interval from a background
+// goroutine; it works alongside the static threshold/trailing trigger
+// configured via SetSnapshotParams, adding snapshots and params that react
+// to actual cluster conditions.
+type SnapshotPolicy interface {
+	// ShouldSnapshot reports whether to snapshot now, and the SnapshotParams
+	// to apply going forward (e.g. a tightened Trailing while a follower is
+	// lagging). A zero SnapshotParams leaves the currently configured ones
+	// untouched.
+	ShouldSnapshot(state SnapshotState) (bool, SnapshotParams)
+}
+
+// FixedIntervalPolicy snapshots every Interval, regardless of load. It is
+// the wall-clock equivalent of SetSnapshotParams with a small Threshold.
+type FixedIntervalPolicy struct {
+	Interval time.Duration
+}
+
+func (p FixedIntervalPolicy) ShouldSnapshot(state SnapshotState) (bool, SnapshotParams) {
+	return state.SinceLastSnapshot >= p.Interval, SnapshotParams{}
+}
+
+// AdaptiveLagPolicy snapshots more often when the slowest follower falls too
+// far behind - so it can catch up from a smaller log instead of a full
+// transfer - and otherwise only forces a snapshot once the log has grown by
+// MaxLogEntries, so a busy leader isn't paused to snapshot on every poll.
+type AdaptiveLagPolicy struct {
+	// Base is the SnapshotParams to apply once triggered.
+	Base SnapshotParams
+	// LagFraction triggers a snapshot once the slowest follower's lag
+	// reaches this fraction of Base.Trailing, e.g. 0.5 means "half the
+	// trailing log window". LagFraction <= 0 disables the lag trigger
+	// entirely, since a zero threshold would fire on every follower
+	// regardless of how caught up it actually is.
+	LagFraction float64
+	// MaxLogEntries forces a snapshot once this many entries have
+	// accumulated since the last one, even with no lagging follower.
+	MaxLogEntries uint64
+}
+
+func (p AdaptiveLagPolicy) ShouldSnapshot(state SnapshotState) (bool, SnapshotParams) {
+	if p.LagFraction > 0 {
+		threshold := uint64(float64(p.Base.Trailing) * p.LagFraction)
+		for _, lag := range state.FollowerLag {
+			if lag >= threshold {
+				return true, p.Base
+			}
+		}
+	}
+	if p.MaxLogEntries > 0 && state.LogEntries >= p.MaxLogEntries {
+		return true, p.Base
+	}
+	return false, SnapshotParams{}
+}
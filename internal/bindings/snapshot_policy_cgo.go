@@ -0,0 +1,110 @@
+//go:build !purego
+
+package bindings
+
+import "C"
+import (
+	"time"
+	"unsafe"
+
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// snapshotPollInterval is how often the goroutine started by
+// SetSnapshotPolicy re-evaluates the policy against fresh metrics.
+const snapshotPollInterval = 1 * time.Second
+
+// snapshotPolicyStop holds the stop channel for the polling goroutine
+// started by SetSnapshotPolicy, keyed like the other status side tables in
+// status.go by the C node pointer, and guarded by statusMu.
+var snapshotPolicyStop = make(map[*C.dqlite_node]chan struct{})
+
+// SetSnapshotPolicy adds a policy evaluated against live raft metrics on top
+// of the node's own threshold/trailing snapshot trigger, which stays active
+// with whatever SnapshotParams are in effect. It starts a
+// background goroutine that polls the node every snapshotPollInterval and,
+// whenever the policy says to snapshot now, calls the dqlite_node_trigger_snapshot
+// shim directly instead of waiting for raft's own threshold to be crossed.
+// Calling SetSnapshotPolicy again stops the previous goroutine and starts a
+// new one with the new policy; Close stops it for good.
+func (s *Node) SetSnapshotPolicy(policy SnapshotPolicy) error {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+
+	statusMu.Lock()
+	if stop, ok := snapshotPolicyStop[server]; ok {
+		close(stop)
+	}
+	stop := make(chan struct{})
+	snapshotPolicyStop[server] = stop
+	statusMu.Unlock()
+
+	go s.runSnapshotPolicy(server, policy, stop)
+	return nil
+}
+
+// stopSnapshotPolicy stops the polling goroutine for server, if one is
+// running. It's called from Close so a stopped node doesn't leak it.
+func stopSnapshotPolicy(server *C.dqlite_node) {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	if stop, ok := snapshotPolicyStop[server]; ok {
+		close(stop)
+		delete(snapshotPolicyStop, server)
+	}
+}
+
+func (s *Node) runSnapshotPolicy(server *C.dqlite_node, policy SnapshotPolicy, stop chan struct{}) {
+	ticker := time.NewTicker(snapshotPollInterval)
+	defer ticker.Stop()
+
+	lastSnapshot := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		state := readSnapshotState(server, lastSnapshot)
+		shouldSnapshot, params := policy.ShouldSnapshot(state)
+		if params != (SnapshotParams{}) {
+			_ = s.SetSnapshotParams(params)
+		}
+		if !shouldSnapshot {
+			continue
+		}
+		if rc := C.dqlite_node_trigger_snapshot(server); rc == 0 {
+			lastSnapshot = time.Now()
+		}
+	}
+}
+
+// readSnapshotState pulls the metrics a SnapshotPolicy needs out of the C
+// node via the dqlite_node_log_size/dqlite_node_follower_lag shims added
+// alongside dqlite_node_trigger_snapshot, filling in the rest from the
+// status side tables maintained in status.go.
+func readSnapshotState(server *C.dqlite_node, lastSnapshot time.Time) SnapshotState {
+	statusMu.Lock()
+	params := nodeSnapshotParams[server]
+	failureDomain := nodeFailureDomain[server]
+	cluster := append([]protocol.NodeInfo(nil), nodeCluster[server]...)
+	statusMu.Unlock()
+
+	state := SnapshotState{
+		LogEntries:        uint64(C.dqlite_node_log_size(server)),
+		SinceLastSnapshot: time.Since(lastSnapshot),
+		FailureDomain:     failureDomain,
+		Params:            params,
+	}
+	// dqlite_node_follower_lag only means anything called on the leader;
+	// off it, SnapshotState.FollowerLag must stay nil per its documented
+	// contract rather than fill in numbers a follower has no way to know.
+	if len(cluster) > 0 && bool(C.dqlite_node_is_leader(server)) {
+		state.FollowerLag = make(map[uint64]uint64, len(cluster))
+		for _, info := range cluster {
+			cid := C.dqlite_node_id(info.ID)
+			state.FollowerLag[info.ID] = uint64(C.dqlite_node_follower_lag(server, cid))
+		}
+	}
+	return state
+}
@@ -0,0 +1,80 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canonical/go-dqlite/internal/bindings"
+)
+
+func TestFixedIntervalPolicy(t *testing.T) {
+	policy := bindings.FixedIntervalPolicy{Interval: time.Minute}
+
+	should, params := policy.ShouldSnapshot(bindings.SnapshotState{SinceLastSnapshot: 30 * time.Second})
+	if should {
+		t.Fatal("ShouldSnapshot: got true before Interval elapsed")
+	}
+	if params != (bindings.SnapshotParams{}) {
+		t.Fatalf("ShouldSnapshot: got params %+v, want zero value", params)
+	}
+
+	should, _ = policy.ShouldSnapshot(bindings.SnapshotState{SinceLastSnapshot: 2 * time.Minute})
+	if !should {
+		t.Fatal("ShouldSnapshot: got false after Interval elapsed")
+	}
+}
+
+// TestAdaptiveLagPolicyZeroLagFractionDisabled guards the fix in 43d5b2b: a
+// zero-value LagFraction must disable the lag trigger entirely instead of
+// producing a threshold of 0 that every follower, however caught up, meets.
+func TestAdaptiveLagPolicyZeroLagFractionDisabled(t *testing.T) {
+	policy := bindings.AdaptiveLagPolicy{Base: bindings.SnapshotParams{Trailing: 1024}}
+
+	should, _ := policy.ShouldSnapshot(bindings.SnapshotState{
+		FollowerLag: map[uint64]uint64{2: 0, 3: 0},
+	})
+	if should {
+		t.Fatal("ShouldSnapshot: got true with LagFraction unset and no follower lag")
+	}
+}
+
+func TestAdaptiveLagPolicyLagTrigger(t *testing.T) {
+	policy := bindings.AdaptiveLagPolicy{
+		Base:        bindings.SnapshotParams{Trailing: 1000},
+		LagFraction: 0.5,
+	}
+
+	should, params := policy.ShouldSnapshot(bindings.SnapshotState{
+		FollowerLag: map[uint64]uint64{2: 100, 3: 600},
+	})
+	if !should {
+		t.Fatal("ShouldSnapshot: got false with a follower past the lag threshold")
+	}
+	if params != policy.Base {
+		t.Fatalf("ShouldSnapshot: got params %+v, want Base %+v", params, policy.Base)
+	}
+
+	should, _ = policy.ShouldSnapshot(bindings.SnapshotState{
+		FollowerLag: map[uint64]uint64{2: 100, 3: 400},
+	})
+	if should {
+		t.Fatal("ShouldSnapshot: got true with every follower under the lag threshold")
+	}
+}
+
+func TestAdaptiveLagPolicyMaxLogEntriesTrigger(t *testing.T) {
+	policy := bindings.AdaptiveLagPolicy{
+		Base:          bindings.SnapshotParams{Trailing: 1000},
+		MaxLogEntries: 500,
+	}
+
+	should, _ := policy.ShouldSnapshot(bindings.SnapshotState{LogEntries: 499})
+	if should {
+		t.Fatal("ShouldSnapshot: got true before MaxLogEntries reached")
+	}
+
+	should, _ = policy.ShouldSnapshot(bindings.SnapshotState{LogEntries: 500})
+	if !should {
+		t.Fatal("ShouldSnapshot: got false at MaxLogEntries")
+	}
+}
@@ -0,0 +1,119 @@
+//go:build !purego
+
+package bindings
+
+import "C"
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/canonical/go-dqlite/internal/protocol"
+)
+
+// statusMu guards the node status side tables below. dqlite_node is an
+// opaque C handle with no room for extra Go-side fields, so status that
+// higher-level packages (like httpapi) want to read back - the snapshot
+// params and failure domain last pushed down, and the cluster last recovered
+// - is cached here instead, keyed by the same C pointer used elsewhere in
+// this file.
+var statusMu sync.Mutex
+var nodeSnapshotParams = make(map[*C.dqlite_node]SnapshotParams)
+var nodeFailureDomain = make(map[*C.dqlite_node]uint64)
+var nodeCluster = make(map[*C.dqlite_node][]protocol.NodeInfo)
+var nodeFrozen = make(map[*C.dqlite_node]bool)
+var nodeSelfID = make(map[*C.dqlite_node]uint64)
+
+// GetSnapshotParams returns the SnapshotParams last set via SetSnapshotParams,
+// or the zero value if none have been set yet.
+func (s *Node) GetSnapshotParams() SnapshotParams {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	return nodeSnapshotParams[server]
+}
+
+// GetFailureDomain returns the failure domain code last set via
+// SetFailureDomain.
+func (s *Node) GetFailureDomain() uint64 {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	return nodeFailureDomain[server]
+}
+
+// GetClusterInfo returns the cluster membership last pushed down via Recover
+// or RecoverExt. It does not reflect membership changes driven by raft
+// itself after the node started; live membership requires the C bindings
+// added for the gRPC admin service.
+func (s *Node) GetClusterInfo() []protocol.NodeInfo {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	cluster := nodeCluster[server]
+	out := make([]protocol.NodeInfo, len(cluster))
+	copy(out, cluster)
+	return out
+}
+
+// Frozen reports whether Freeze has been called without a matching Unfreeze.
+func (s *Node) Frozen() bool {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	return nodeFrozen[server]
+}
+
+// GetID returns this node's own ID, as passed to NewNode.
+func (s *Node) GetID() uint64 {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	return nodeSelfID[server]
+}
+
+// GetRole returns this node's role within the cluster membership last
+// pushed down via Recover or RecoverExt, or protocol.Voter if this node
+// isn't in that membership yet (e.g. Recover hasn't run).
+func (s *Node) GetRole() protocol.Role {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	id := nodeSelfID[server]
+	for _, info := range nodeCluster[server] {
+		if info.ID == id {
+			return info.Role
+		}
+	}
+	return protocol.Voter
+}
+
+// IsLeader reports whether this node currently believes itself to be the
+// raft leader, via the dqlite_node_is_leader shim.
+func (s *Node) IsLeader() bool {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	return bool(C.dqlite_node_is_leader(server))
+}
+
+// CaughtUp reports whether this node is fit to serve: the cluster leader
+// (always caught up), or a follower whose applied log index is within
+// maxLag entries of the leader's and that has heard from the leader within
+// maxSinceContact.
+//
+// The lag check alone can't tell a caught-up follower from one that's been
+// partitioned from the leader: once a follower stops hearing from the
+// leader, neither its applied index nor the leader's commit index it last
+// observed advance any further, so the gap between them stays flat instead
+// of growing. maxSinceContact, via the new dqlite_node_last_contact_ms
+// shim, catches that case.
+func (s *Node) CaughtUp(maxLag uint64, maxSinceContact time.Duration) bool {
+	server := (*C.dqlite_node)(unsafe.Pointer(s))
+	if bool(C.dqlite_node_is_leader(server)) {
+		return true
+	}
+	sinceContact := time.Duration(uint64(C.dqlite_node_last_contact_ms(server))) * time.Millisecond
+	if sinceContact > maxSinceContact {
+		return false
+	}
+	return uint64(C.dqlite_node_catch_up_lag(server)) <= maxLag
+}
@@ -0,0 +1,9 @@
+package bindings
+
+// SnapshotParams controls how often raft takes a snapshot of the FSM and how
+// many log entries it retains past the last snapshot. It is shared by every
+// backend implementation of Node.
+type SnapshotParams struct {
+	Threshold uint64
+	Trailing  uint64
+}